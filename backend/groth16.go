@@ -0,0 +1,58 @@
+package backend
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/witness"
+)
+
+// Groth16BN254 is the Groth16 scheme over BN254. It builds a classic R1CS
+// rather than Plonk's sparse R1CS, and its setup is circuit-specific (an
+// MPC phase-2 ceremony in production) rather than SRS-based.
+type Groth16BN254 struct{}
+
+func (Groth16BN254) Name() string { return "groth16" }
+
+func (Groth16BN254) Compile(circuit frontend.Circuit) (constraint.ConstraintSystem, error) {
+	return frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, circuit)
+}
+
+func (Groth16BN254) Setup(ccs constraint.ConstraintSystem) (ProvingKey, VerifyingKey, error) {
+	pk, vk, err := groth16.Setup(ccs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("backend: groth16 setup: %w", err)
+	}
+	return pk, vk, nil
+}
+
+func (Groth16BN254) Prove(ccs constraint.ConstraintSystem, pk ProvingKey, fullWitness witness.Witness) (Proof, error) {
+	proof, err := groth16.Prove(ccs, pk.(groth16.ProvingKey), fullWitness)
+	if err != nil {
+		return nil, fmt.Errorf("backend: groth16 prove: %w", err)
+	}
+	return proof, nil
+}
+
+func (Groth16BN254) Verify(proof Proof, vk VerifyingKey, publicWitness witness.Witness) error {
+	if err := groth16.Verify(proof.(groth16.Proof), vk.(groth16.VerifyingKey), publicWitness); err != nil {
+		return fmt.Errorf("backend: groth16 verify: %w", err)
+	}
+	return nil
+}
+
+func (Groth16BN254) MarshalProof(proof Proof) ([]byte, error) {
+	return marshal("groth16", proof)
+}
+
+func (Groth16BN254) UnmarshalProof(data []byte) (Proof, error) {
+	proof := groth16.NewProof(ecc.BN254)
+	if err := unmarshal("groth16", data, proof); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
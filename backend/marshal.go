@@ -0,0 +1,25 @@
+package backend
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// marshal serializes anything implementing io.WriterTo to a byte slice.
+// Every scheme's MarshalProof delegates to this.
+func marshal(name string, w io.WriterTo) ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := w.WriteTo(&buf); err != nil {
+		return nil, fmt.Errorf("backend: marshaling %s proof: %w", name, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// unmarshal decodes data into dst, which must implement io.ReaderFrom.
+func unmarshal(name string, data []byte, dst io.ReaderFrom) error {
+	if _, err := dst.ReadFrom(bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("backend: unmarshaling %s proof: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package backend
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/plonk"
+	gnarkplonk "github.com/consensys/gnark/backend/plonk/bn254"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/consensys/gnark/witness"
+	"github.com/succinctlabs/sp1-recursion-groth16/srs"
+)
+
+// PlonkBN254 is the Plonk scheme over BN254, backed by a KZG SRS. This was
+// the harness's original hardwired backend.
+type PlonkBN254 struct{}
+
+func (PlonkBN254) Name() string { return "plonk" }
+
+func (PlonkBN254) Compile(circuit frontend.Circuit) (constraint.ConstraintSystem, error) {
+	return frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, circuit)
+}
+
+func (PlonkBN254) Setup(ccs constraint.ConstraintSystem) (ProvingKey, VerifyingKey, error) {
+	kzgSRS, err := srs.Load(ccs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("backend: loading srs: %w", err)
+	}
+	pk, vk, err := plonk.Setup(ccs, kzgSRS)
+	if err != nil {
+		return nil, nil, fmt.Errorf("backend: plonk setup: %w", err)
+	}
+	return pk, vk, nil
+}
+
+func (PlonkBN254) Prove(ccs constraint.ConstraintSystem, pk ProvingKey, fullWitness witness.Witness) (Proof, error) {
+	proof, err := plonk.Prove(ccs, pk.(*gnarkplonk.ProvingKey), fullWitness)
+	if err != nil {
+		return nil, fmt.Errorf("backend: plonk prove: %w", err)
+	}
+	return proof, nil
+}
+
+func (PlonkBN254) Verify(proof Proof, vk VerifyingKey, publicWitness witness.Witness) error {
+	if err := plonk.Verify(proof.(*gnarkplonk.Proof), vk.(*gnarkplonk.VerifyingKey), publicWitness); err != nil {
+		return fmt.Errorf("backend: plonk verify: %w", err)
+	}
+	return nil
+}
+
+func (PlonkBN254) MarshalProof(proof Proof) ([]byte, error) {
+	return marshal("plonk", proof)
+}
+
+func (PlonkBN254) UnmarshalProof(data []byte) (Proof, error) {
+	proof := new(gnarkplonk.Proof)
+	if err := unmarshal("plonk", data, proof); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
@@ -0,0 +1,59 @@
+package backend
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/plonkfri"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/consensys/gnark/witness"
+)
+
+// PlonkFRIBN254 is the Plonk scheme with a FRI-based, transparent setup:
+// no SRS and no ceremony, at the cost of larger proofs than KZG-backed
+// Plonk. Useful when a project would rather not depend on any trusted
+// setup at all.
+type PlonkFRIBN254 struct{}
+
+func (PlonkFRIBN254) Name() string { return "plonkfri" }
+
+func (PlonkFRIBN254) Compile(circuit frontend.Circuit) (constraint.ConstraintSystem, error) {
+	return frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, circuit)
+}
+
+func (PlonkFRIBN254) Setup(ccs constraint.ConstraintSystem) (ProvingKey, VerifyingKey, error) {
+	pk, vk, err := plonkfri.Setup(ccs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("backend: plonkfri setup: %w", err)
+	}
+	return pk, vk, nil
+}
+
+func (PlonkFRIBN254) Prove(ccs constraint.ConstraintSystem, pk ProvingKey, fullWitness witness.Witness) (Proof, error) {
+	proof, err := plonkfri.Prove(ccs, pk.(plonkfri.ProvingKey), fullWitness)
+	if err != nil {
+		return nil, fmt.Errorf("backend: plonkfri prove: %w", err)
+	}
+	return proof, nil
+}
+
+func (PlonkFRIBN254) Verify(proof Proof, vk VerifyingKey, publicWitness witness.Witness) error {
+	if err := plonkfri.Verify(proof.(plonkfri.Proof), vk.(plonkfri.VerifyingKey), publicWitness); err != nil {
+		return fmt.Errorf("backend: plonkfri verify: %w", err)
+	}
+	return nil
+}
+
+func (PlonkFRIBN254) MarshalProof(proof Proof) ([]byte, error) {
+	return marshal("plonkfri", proof)
+}
+
+func (PlonkFRIBN254) UnmarshalProof(data []byte) (Proof, error) {
+	proof := plonkfri.NewProof()
+	if err := unmarshal("plonkfri", data, proof); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
@@ -0,0 +1,56 @@
+// Package backend abstracts over gnark's proving schemes (Groth16, Plonk,
+// and the transparent-setup PlonkFRI variant) behind one interface, so the
+// test harness can pick a scheme via the SCHEME environment variable
+// instead of being hardwired to Plonk+SCS+KZG. The sp1-groth16 CLI does
+// not use this package; it always runs Plonk.
+package backend
+
+import (
+	"io"
+
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/witness"
+)
+
+// ProvingKey, VerifyingKey and Proof are satisfied by every backend's
+// corresponding gnark type (groth16.ProvingKey, plonk.ProvingKey, ...),
+// all of which already implement WriteTo/ReadFrom.
+type ProvingKey interface {
+	io.WriterTo
+	io.ReaderFrom
+}
+
+type VerifyingKey interface {
+	io.WriterTo
+	io.ReaderFrom
+}
+
+type Proof interface {
+	io.WriterTo
+	io.ReaderFrom
+}
+
+// Scheme is a proving backend: a circuit builder, a setup phase, and
+// prove/verify over the resulting keys. Groth16BN254, PlonkBN254 and
+// PlonkFRIBN254 are the concrete backends; Select picks one by name.
+type Scheme interface {
+	// Name identifies the scheme, e.g. for logging and the SCHEME env var.
+	Name() string
+
+	// Compile builds circuit into a ConstraintSystem using this scheme's
+	// native builder (R1CS for Groth16, sparse R1CS for Plonk/PlonkFRI).
+	Compile(circuit frontend.Circuit) (constraint.ConstraintSystem, error)
+
+	// Setup runs this scheme's setup phase over ccs. Schemes that need an
+	// SRS (Plonk) load one via the srs package; schemes that don't
+	// (Groth16's circuit-specific MPC, PlonkFRI's transparent setup)
+	// ignore it entirely.
+	Setup(ccs constraint.ConstraintSystem) (ProvingKey, VerifyingKey, error)
+
+	Prove(ccs constraint.ConstraintSystem, pk ProvingKey, fullWitness witness.Witness) (Proof, error)
+	Verify(proof Proof, vk VerifyingKey, publicWitness witness.Witness) error
+
+	MarshalProof(proof Proof) ([]byte, error)
+	UnmarshalProof(data []byte) (Proof, error)
+}
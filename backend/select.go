@@ -0,0 +1,24 @@
+package backend
+
+import (
+	"fmt"
+	"os"
+)
+
+// EnvScheme is the environment variable read by Select.
+const EnvScheme = "SCHEME"
+
+// Select returns the Scheme named by the SCHEME environment variable,
+// defaulting to PlonkBN254 (the harness's original behavior) when unset.
+func Select() (Scheme, error) {
+	switch name := os.Getenv(EnvScheme); name {
+	case "", "plonk":
+		return PlonkBN254{}, nil
+	case "groth16":
+		return Groth16BN254{}, nil
+	case "plonkfri":
+		return PlonkFRIBN254{}, nil
+	default:
+		return nil, fmt.Errorf("backend: unknown SCHEME %q", name)
+	}
+}
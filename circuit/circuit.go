@@ -0,0 +1,75 @@
+// Package circuit holds the SP1 recursion verifier circuit and the JSON
+// witness format produced by the SP1 recursion prover, shared by the test
+// harness and the sp1-groth16 CLI so both compile the exact same circuit.
+package circuit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/succinctlabs/sp1-recursion-groth16/babybear"
+)
+
+// Witness is the JSON-serializable form of a Circuit's inputs, as emitted
+// by the SP1 recursion prover.
+type Witness struct {
+	Vars  []string `json:"vars"`
+	Felts []string `json:"felts"`
+	Exts  []string `json:"exts"`
+}
+
+// Circuit is the SP1 recursion verifier circuit: it checks the babybear
+// field and extension-field arithmetic performed inside SP1's STARK,
+// expressed as BN254 constraints so it can be wrapped in a single Plonk
+// or Groth16 proof.
+type Circuit struct {
+	Vars  []frontend.Variable
+	Felts []*babybear.Variable
+	Exts  []*babybear.ExtensionVariable
+}
+
+// Define declares the circuit's constraints.
+func (c *Circuit) Define(api frontend.API) error {
+	chip := babybear.NewChip(api)
+	for _, f := range c.Felts {
+		chip.RangeCheck(f)
+	}
+	for _, e := range c.Exts {
+		chip.RangeCheckExtension(e)
+	}
+	return nil
+}
+
+// New converts a JSON witness into a Circuit ready for compilation or
+// witness generation.
+func New(w Witness) Circuit {
+	vars := make([]frontend.Variable, len(w.Vars))
+	felts := make([]*babybear.Variable, len(w.Felts))
+	exts := make([]*babybear.ExtensionVariable, len(w.Exts))
+	for i := 0; i < len(w.Vars); i++ {
+		vars[i] = frontend.Variable(w.Vars[i])
+	}
+	for i := 0; i < len(w.Felts); i++ {
+		felts[i] = babybear.NewF(w.Felts[i])
+	}
+	for i := 0; i < len(w.Exts); i++ {
+		exts[i] = babybear.NewE(w.Exts[i])
+	}
+	return Circuit{Vars: vars, Felts: felts, Exts: exts}
+}
+
+// LoadWitness reads and decodes a witness JSON file, e.g. the witness.json
+// produced alongside an SP1 recursion proof.
+func LoadWitness(path string) (Witness, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Witness{}, fmt.Errorf("circuit: reading witness %q: %w", path, err)
+	}
+	var w Witness
+	if err := json.Unmarshal(data, &w); err != nil {
+		return Witness{}, fmt.Errorf("circuit: decoding witness %q: %w", path, err)
+	}
+	return w, nil
+}
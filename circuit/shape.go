@@ -0,0 +1,72 @@
+package circuit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/succinctlabs/sp1-recursion-groth16/babybear"
+)
+
+// Shape describes a witness's dimensions only: how many variables, field
+// elements, and extension-field elements it carries. It's enough to
+// rebuild a Circuit's structure for decoding a public witness without the
+// underlying private values, so steps like "verify" don't need access to
+// the prover's original (private) witness.json.
+type Shape struct {
+	NbVars  int `json:"nb_vars"`
+	NbFelts int `json:"nb_felts"`
+	NbExts  int `json:"nb_exts"`
+}
+
+// ShapeOf returns w's Shape.
+func ShapeOf(w Witness) Shape {
+	return Shape{NbVars: len(w.Vars), NbFelts: len(w.Felts), NbExts: len(w.Exts)}
+}
+
+// Blank builds a Circuit with s's dimensions and zero-valued entries. It's
+// sufficient for decoding a public witness against (the decoder only needs
+// the slice lengths to line up), but not for compiling or proving.
+func Blank(s Shape) Circuit {
+	vars := make([]frontend.Variable, s.NbVars)
+	felts := make([]*babybear.Variable, s.NbFelts)
+	exts := make([]*babybear.ExtensionVariable, s.NbExts)
+	for i := range vars {
+		vars[i] = frontend.Variable(0)
+	}
+	for i := range felts {
+		felts[i] = babybear.NewF("0")
+	}
+	for i := range exts {
+		exts[i] = babybear.NewE("0")
+	}
+	return Circuit{Vars: vars, Felts: felts, Exts: exts}
+}
+
+// SaveShape writes s as JSON to path, e.g. alongside r1cs.bin so a later,
+// independent "verify" step can rebuild the circuit's structure without
+// needing the original witness.json.
+func SaveShape(path string, s Shape) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("circuit: marshaling shape: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("circuit: writing shape %q: %w", path, err)
+	}
+	return nil
+}
+
+// LoadShape reads back a Shape written by SaveShape.
+func LoadShape(path string) (Shape, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Shape{}, fmt.Errorf("circuit: reading shape %q: %w", path, err)
+	}
+	var s Shape
+	if err := json.Unmarshal(data, &s); err != nil {
+		return Shape{}, fmt.Errorf("circuit: decoding shape %q: %w", path, err)
+	}
+	return s, nil
+}
@@ -0,0 +1,31 @@
+package circuit
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestShapeOf(t *testing.T) {
+	w := Witness{Vars: []string{"1", "2"}, Felts: []string{"3"}, Exts: []string{"4", "5", "6"}}
+	got := ShapeOf(w)
+	want := Shape{NbVars: 2, NbFelts: 1, NbExts: 3}
+	if got != want {
+		t.Errorf("ShapeOf(%+v) = %+v, want %+v", w, got, want)
+	}
+}
+
+func TestSaveLoadShape(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "shape.json")
+	want := Shape{NbVars: 7, NbFelts: 8, NbExts: 9}
+
+	if err := SaveShape(path, want); err != nil {
+		t.Fatalf("SaveShape: %v", err)
+	}
+	got, err := LoadShape(path)
+	if err != nil {
+		t.Fatalf("LoadShape: %v", err)
+	}
+	if got != want {
+		t.Errorf("LoadShape() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// writeTo writes an object implementing gnark's io.WriterTo to path,
+// overwriting any existing file.
+func writeTo(path string, w io.WriterTo) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("creating %q: %w", path, err)
+	}
+	defer f.Close()
+
+	if _, err := w.WriteTo(f); err != nil {
+		return fmt.Errorf("writing %q: %w", path, err)
+	}
+	return nil
+}
+
+// readFrom decodes an object implementing gnark's io.ReaderFrom from path.
+func readFrom(path string, r io.ReaderFrom) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("opening %q: %w", path, err)
+	}
+	defer f.Close()
+
+	if _, err := r.ReadFrom(f); err != nil {
+		return fmt.Errorf("reading %q: %w", path, err)
+	}
+	return nil
+}
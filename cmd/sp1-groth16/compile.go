@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/spf13/cobra"
+	"github.com/succinctlabs/sp1-recursion-groth16/circuit"
+	"github.com/succinctlabs/sp1-recursion-groth16/stats"
+)
+
+func newCompileCmd() *cobra.Command {
+	var witnessPath, r1csPath, shapePath, profilePath string
+	var profile bool
+
+	cmd := &cobra.Command{
+		Use:   "compile",
+		Short: "Compile the recursion circuit to a portable R1CS artifact",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			w, err := circuit.LoadWitness(witnessPath)
+			if err != nil {
+				return err
+			}
+			c := circuit.New(w)
+
+			var ccs constraint.ConstraintSystem
+			err = timePhase("compile", r1csPath, func() error {
+				r1cs, err := frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, &c)
+				if err != nil {
+					return fmt.Errorf("compiling circuit: %w", err)
+				}
+				ccs = r1cs
+				return writeTo(r1csPath, r1cs)
+			})
+			if err != nil {
+				return err
+			}
+
+			// Persist the witness's shape alongside the R1CS, so "verify"
+			// can rebuild the circuit's structure without needing access
+			// to this (private) witness.json.
+			if err := circuit.SaveShape(shapePath, circuit.ShapeOf(w)); err != nil {
+				return err
+			}
+
+			if profile {
+				report := stats.Of(ccs)
+				data, err := report.JSON()
+				if err != nil {
+					return fmt.Errorf("marshaling profile: %w", err)
+				}
+				if err := os.WriteFile(profilePath, data, 0o644); err != nil {
+					return fmt.Errorf("writing profile: %w", err)
+				}
+				report.Pretty(os.Stderr)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&witnessPath, "witness", "witness.json", "witness JSON used to size the circuit")
+	cmd.Flags().StringVar(&r1csPath, "r1cs", "r1cs.bin", "output path for the compiled R1CS")
+	cmd.Flags().StringVar(&shapePath, "shape", "shape.json", "output path for the witness shape, used later by verify")
+	cmd.Flags().BoolVar(&profile, "profile", false, "report constraint-profile statistics after compiling")
+	cmd.Flags().StringVar(&profilePath, "profile-out", "profile.json", "output path for the constraint-profile report")
+	return cmd
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	gnarkplonk "github.com/consensys/gnark/backend/plonk/bn254"
+	"github.com/spf13/cobra"
+)
+
+func newExportSolidityCmd() *cobra.Command {
+	var vkPath, outPath string
+
+	cmd := &cobra.Command{
+		Use:   "export-solidity",
+		Short: "Export a Plonk verifier contract for the vk, for onchain verification",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vk := new(gnarkplonk.VerifyingKey)
+			if err := readFrom(vkPath, vk); err != nil {
+				return fmt.Errorf("loading verifying key: %w", err)
+			}
+
+			return timePhase("export-solidity", outPath, func() error {
+				f, err := os.Create(outPath)
+				if err != nil {
+					return fmt.Errorf("creating %q: %w", outPath, err)
+				}
+				defer f.Close()
+
+				if err := vk.ExportSolidity(f); err != nil {
+					return fmt.Errorf("exporting solidity verifier: %w", err)
+				}
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&vkPath, "vk", "vk.bin", "path to the verifying key")
+	cmd.Flags().StringVar(&outPath, "out", "Verifier.sol", "output path for the Solidity verifier contract")
+	return cmd
+}
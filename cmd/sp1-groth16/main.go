@@ -0,0 +1,32 @@
+// Command sp1-groth16 splits circuit compilation, setup, proving and
+// verification into independent steps backed by on-disk artifacts, so a CI
+// pipeline can run each phase separately instead of recompiling the circuit
+// and regenerating the SRS on every proof.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func main() {
+	root := &cobra.Command{
+		Use:   "sp1-groth16",
+		Short: "Compile, setup, prove and verify the SP1 recursion circuit",
+	}
+	root.AddCommand(
+		newCompileCmd(),
+		newSetupCmd(),
+		newProveCmd(),
+		newVerifyCmd(),
+		newExportSolidityCmd(),
+		newProveBatchCmd(),
+	)
+
+	if err := root.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/plonk"
+	gnarkplonk "github.com/consensys/gnark/backend/plonk/bn254"
+	"github.com/consensys/gnark/frontend"
+	"github.com/spf13/cobra"
+	"github.com/succinctlabs/sp1-recursion-groth16/circuit"
+)
+
+func newProveCmd() *cobra.Command {
+	var r1csPath, pkPath, witnessPath, proofPath, publicPath string
+
+	cmd := &cobra.Command{
+		Use:   "prove",
+		Short: "Generate a proof for a witness against an existing pk, without recompiling",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ccs, err := loadR1CS(r1csPath)
+			if err != nil {
+				return err
+			}
+
+			pk := new(gnarkplonk.ProvingKey)
+			if err := readFrom(pkPath, pk); err != nil {
+				return fmt.Errorf("loading proving key: %w", err)
+			}
+
+			w, err := circuit.LoadWitness(witnessPath)
+			if err != nil {
+				return err
+			}
+			c := circuit.New(w)
+
+			return timePhase("prove", proofPath, func() error {
+				witnessFull, err := frontend.NewWitness(&c, ecc.BN254.ScalarField())
+				if err != nil {
+					return fmt.Errorf("building full witness: %w", err)
+				}
+				witnessPublic, err := frontend.NewWitness(&c, ecc.BN254.ScalarField(), frontend.PublicOnly())
+				if err != nil {
+					return fmt.Errorf("building public witness: %w", err)
+				}
+
+				proof, err := plonk.Prove(ccs, pk, witnessFull)
+				if err != nil {
+					return fmt.Errorf("proving: %w", err)
+				}
+				if err := writeTo(proofPath, proof); err != nil {
+					return err
+				}
+
+				publicJSON, err := witnessPublic.MarshalJSON()
+				if err != nil {
+					return fmt.Errorf("marshaling public witness: %w", err)
+				}
+				return os.WriteFile(publicPath, publicJSON, 0o644)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&r1csPath, "r1cs", "r1cs.bin", "path to the compiled R1CS")
+	cmd.Flags().StringVar(&pkPath, "pk", "pk.bin", "path to the proving key")
+	cmd.Flags().StringVar(&witnessPath, "witness", "witness.json", "witness JSON to prove")
+	cmd.Flags().StringVar(&proofPath, "proof", "proof.bin", "output path for the proof")
+	cmd.Flags().StringVar(&publicPath, "public", "public.json", "output path for the public witness")
+	return cmd
+}
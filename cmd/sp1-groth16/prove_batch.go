@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/succinctlabs/sp1-recursion-groth16/prover"
+)
+
+func newProveBatchCmd() *cobra.Command {
+	var witnessGlob, outDir, vkPath string
+	var concurrency int
+
+	cmd := &cobra.Command{
+		Use:   "prove-batch",
+		Short: "Compile and set up once, then prove many witnesses concurrently",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			witnesses, err := prover.LoadWitnessDir(witnessGlob)
+			if err != nil {
+				return err
+			}
+
+			var p *prover.Prover
+			if err := timePhase("setup", witnessGlob, func() error {
+				var err error
+				p, err = prover.New(witnesses[0])
+				return err
+			}); err != nil {
+				return err
+			}
+
+			if vkPath != "" {
+				if err := writeTo(vkPath, p.VerifyingKey()); err != nil {
+					return err
+				}
+			}
+
+			return timePhase("prove-batch", outDir, func() error {
+				proofs, err := p.ProveBatch(context.Background(), witnesses, concurrency)
+				if err != nil {
+					return fmt.Errorf("proving batch: %w", err)
+				}
+				for _, proof := range proofs {
+					if err := prover.SaveProof(outDir, proof); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&witnessGlob, "witnesses", "witnesses/*.json", "directory or glob of witness JSON files to prove")
+	cmd.Flags().StringVar(&outDir, "out", ".", "output directory for proof-<i>.bin / public-<i>.json")
+	cmd.Flags().StringVar(&vkPath, "vk", "vk.bin", "output path for the verifying key produced by this batch's setup")
+	cmd.Flags().IntVar(&concurrency, "concurrency", 4, "number of witnesses to prove concurrently")
+	return cmd
+}
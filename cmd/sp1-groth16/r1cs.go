@@ -0,0 +1,16 @@
+package main
+
+import (
+	"fmt"
+
+	cs "github.com/consensys/gnark/constraint/bn254"
+)
+
+// loadR1CS reads a compiled R1CS previously written by "compile".
+func loadR1CS(path string) (*cs.SparseR1CS, error) {
+	ccs := new(cs.SparseR1CS)
+	if err := readFrom(path, ccs); err != nil {
+		return nil, fmt.Errorf("loading r1cs: %w", err)
+	}
+	return ccs, nil
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/backend/plonk"
+	"github.com/spf13/cobra"
+	"github.com/succinctlabs/sp1-recursion-groth16/srs"
+)
+
+func newSetupCmd() *cobra.Command {
+	var r1csPath, pkPath, vkPath string
+
+	cmd := &cobra.Command{
+		Use:   "setup",
+		Short: "Run the Plonk setup against a compiled R1CS, producing a pk/vk pair",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ccs, err := loadR1CS(r1csPath)
+			if err != nil {
+				return err
+			}
+
+			return timePhase("setup", pkPath, func() error {
+				kzgSRS, err := srs.Load(ccs)
+				if err != nil {
+					return fmt.Errorf("loading srs: %w", err)
+				}
+				pk, vk, err := plonk.Setup(ccs, kzgSRS)
+				if err != nil {
+					return fmt.Errorf("running plonk setup: %w", err)
+				}
+				if err := writeTo(pkPath, pk); err != nil {
+					return err
+				}
+				return writeTo(vkPath, vk)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&r1csPath, "r1cs", "r1cs.bin", "path to the compiled R1CS")
+	cmd.Flags().StringVar(&pkPath, "pk", "pk.bin", "output path for the proving key")
+	cmd.Flags().StringVar(&vkPath, "vk", "vk.bin", "output path for the verifying key")
+	return cmd
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+)
+
+// phaseLog is one line of structured, CI-consumable timing output, emitted
+// once per phase (compile, setup, prove, verify, export-solidity,
+// prove-batch) in place
+// of the plain fmt.Printf lines the original test harness used.
+type phaseLog struct {
+	Phase      string `json:"phase"`
+	DurationMs int64  `json:"duration_ms"`
+	Detail     string `json:"detail,omitempty"`
+}
+
+// timePhase runs fn, then writes a phaseLog line to stdout reporting how
+// long it took.
+func timePhase(phase string, detail string, fn func() error) error {
+	start := time.Now()
+	err := fn()
+	entry := phaseLog{
+		Phase:      phase,
+		DurationMs: time.Since(start).Milliseconds(),
+		Detail:     detail,
+	}
+	enc := json.NewEncoder(os.Stdout)
+	if encErr := enc.Encode(entry); encErr != nil {
+		return encErr
+	}
+	return err
+}
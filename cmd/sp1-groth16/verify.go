@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/plonk"
+	gnarkplonk "github.com/consensys/gnark/backend/plonk/bn254"
+	"github.com/consensys/gnark/frontend"
+	"github.com/spf13/cobra"
+	"github.com/succinctlabs/sp1-recursion-groth16/circuit"
+)
+
+func newVerifyCmd() *cobra.Command {
+	var vkPath, proofPath, publicPath, shapePath string
+
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify a proof against a vk and a public witness, without recompiling",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vk := new(gnarkplonk.VerifyingKey)
+			if err := readFrom(vkPath, vk); err != nil {
+				return fmt.Errorf("loading verifying key: %w", err)
+			}
+
+			proof := new(gnarkplonk.Proof)
+			if err := readFrom(proofPath, proof); err != nil {
+				return fmt.Errorf("loading proof: %w", err)
+			}
+
+			// The public witness needs the circuit's shape (sizes of Vars,
+			// Felts, Exts) to be decoded. That shape is read from
+			// shape.json, written by "compile" alongside r1cs.bin, rather
+			// than from the prover's witness.json — which holds the
+			// private Felts/Exts values and has no business being
+			// readable by whoever runs verify.
+			shape, err := circuit.LoadShape(shapePath)
+			if err != nil {
+				return err
+			}
+			c := circuit.Blank(shape)
+			witnessPublic, err := frontend.NewWitness(&c, ecc.BN254.ScalarField(), frontend.PublicOnly())
+			if err != nil {
+				return fmt.Errorf("building public witness shape: %w", err)
+			}
+			publicJSON, err := os.ReadFile(publicPath)
+			if err != nil {
+				return fmt.Errorf("reading public witness: %w", err)
+			}
+			if err := witnessPublic.UnmarshalJSON(publicJSON); err != nil {
+				return fmt.Errorf("decoding public witness: %w", err)
+			}
+
+			return timePhase("verify", proofPath, func() error {
+				if err := plonk.Verify(proof, vk, witnessPublic); err != nil {
+					return fmt.Errorf("verifying: %w", err)
+				}
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&vkPath, "vk", "vk.bin", "path to the verifying key")
+	cmd.Flags().StringVar(&proofPath, "proof", "proof.bin", "path to the proof")
+	cmd.Flags().StringVar(&publicPath, "public", "public.json", "path to the public witness")
+	cmd.Flags().StringVar(&shapePath, "shape", "shape.json", "path to the witness shape written by compile")
+	return cmd
+}
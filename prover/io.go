@@ -0,0 +1,22 @@
+package prover
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// SaveProof writes a Proof's serialized proof and public witness to
+// outDir as "proof-<index>.bin" and "public-<index>.json".
+func SaveProof(outDir string, p Proof) error {
+	proofPath := filepath.Join(outDir, fmt.Sprintf("proof-%d.bin", p.Index))
+	if err := os.WriteFile(proofPath, p.Bytes, 0o644); err != nil {
+		return fmt.Errorf("prover: writing %q: %w", proofPath, err)
+	}
+
+	publicPath := filepath.Join(outDir, fmt.Sprintf("public-%d.json", p.Index))
+	if err := os.WriteFile(publicPath, p.Public, 0o644); err != nil {
+		return fmt.Errorf("prover: writing %q: %w", publicPath, err)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Package prover compiles the recursion circuit and runs its setup once,
+// then proves many witnesses against the same proving key concurrently.
+// It exists because recursion pipelines need to push hundreds of proofs
+// through one pk, not the single-shot "prove done" pattern of the test
+// harness.
+package prover
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/plonk"
+	gnarkplonk "github.com/consensys/gnark/backend/plonk/bn254"
+	cs "github.com/consensys/gnark/constraint/bn254"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/succinctlabs/sp1-recursion-groth16/circuit"
+	"github.com/succinctlabs/sp1-recursion-groth16/srs"
+	"golang.org/x/sync/errgroup"
+)
+
+// Witness is the JSON witness format proved by a Prover.
+type Witness = circuit.Witness
+
+// Proof is the result of proving a single witness: the serialized proof
+// and the public witness it proves, alongside the witness's position in
+// the batch it was submitted with.
+type Proof struct {
+	Index  int
+	Bytes  []byte
+	Public []byte
+}
+
+// Prover compiles the recursion circuit and runs its setup once, then
+// proves many witnesses against the resulting pk.
+type Prover struct {
+	ccs *cs.SparseR1CS
+	pk  *gnarkplonk.ProvingKey
+	vk  *gnarkplonk.VerifyingKey
+}
+
+// New compiles the recursion circuit and runs its setup using shape as a
+// representative witness (only its Vars/Felts/Exts lengths matter). All
+// witnesses later passed to ProveBatch or ProveStream must share that
+// shape.
+func New(shape Witness) (*Prover, error) {
+	c := circuit.New(shape)
+	r1cs, err := frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, &c)
+	if err != nil {
+		return nil, fmt.Errorf("prover: compiling circuit: %w", err)
+	}
+	ccs := r1cs.(*cs.SparseR1CS)
+
+	kzgSRS, err := srs.Load(ccs)
+	if err != nil {
+		return nil, fmt.Errorf("prover: loading srs: %w", err)
+	}
+	pk, vk, err := plonk.Setup(ccs, kzgSRS)
+	if err != nil {
+		return nil, fmt.Errorf("prover: running setup: %w", err)
+	}
+
+	return &Prover{ccs: ccs, pk: pk.(*gnarkplonk.ProvingKey), vk: vk.(*gnarkplonk.VerifyingKey)}, nil
+}
+
+// VerifyingKey returns the pk's matching vk, so callers can verify proofs
+// produced by this Prover without a separate setup run.
+func (p *Prover) VerifyingKey() *gnarkplonk.VerifyingKey {
+	return p.vk
+}
+
+// prove runs a single witness through the circuit and plonk.Prove.
+func (p *Prover) prove(w Witness) (proofBytes, publicBytes []byte, err error) {
+	c := circuit.New(w)
+
+	witnessFull, err := frontend.NewWitness(&c, ecc.BN254.ScalarField())
+	if err != nil {
+		return nil, nil, fmt.Errorf("building full witness: %w", err)
+	}
+	witnessPublic, err := frontend.NewWitness(&c, ecc.BN254.ScalarField(), frontend.PublicOnly())
+	if err != nil {
+		return nil, nil, fmt.Errorf("building public witness: %w", err)
+	}
+
+	proof, err := plonk.Prove(p.ccs, p.pk, witnessFull)
+	if err != nil {
+		return nil, nil, fmt.Errorf("proving: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := proof.WriteTo(&buf); err != nil {
+		return nil, nil, fmt.Errorf("serializing proof: %w", err)
+	}
+	publicJSON, err := witnessPublic.MarshalJSON()
+	if err != nil {
+		return nil, nil, fmt.Errorf("serializing public witness: %w", err)
+	}
+	return buf.Bytes(), publicJSON, nil
+}
+
+// ProveBatch proves every witness in witnesses concurrently, bounded by
+// concurrency simultaneous plonk.Prove calls, and returns one Proof per
+// witness in the same order. It stops submitting new work once ctx is
+// canceled or any witness fails to prove.
+func (p *Prover) ProveBatch(ctx context.Context, witnesses []Witness, concurrency int) ([]Proof, error) {
+	if concurrency < 1 {
+		return nil, fmt.Errorf("prover: concurrency must be at least 1, got %d", concurrency)
+	}
+
+	proofs := make([]Proof, len(witnesses))
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrency)
+
+	for i, w := range witnesses {
+		i, w := i, w
+		g.Go(func() error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			proofBytes, publicBytes, err := p.prove(w)
+			if err != nil {
+				return fmt.Errorf("proving witness %d: %w", i, err)
+			}
+			proofs[i] = Proof{Index: i, Bytes: proofBytes, Public: publicBytes}
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return proofs, nil
+}
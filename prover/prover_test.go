@@ -0,0 +1,33 @@
+package prover
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestProveBatchRejectsNonPositiveConcurrency(t *testing.T) {
+	var p *Prover
+	for _, concurrency := range []int{0, -1} {
+		_, err := p.ProveBatch(context.Background(), nil, concurrency)
+		if err == nil {
+			t.Fatalf("ProveBatch with concurrency=%d: expected an error, got nil", concurrency)
+		}
+		if !strings.Contains(err.Error(), "concurrency") {
+			t.Errorf("ProveBatch with concurrency=%d: error = %v, want it to mention concurrency", concurrency, err)
+		}
+	}
+}
+
+func TestProveStreamRejectsNonPositiveConcurrency(t *testing.T) {
+	var p *Prover
+	for _, concurrency := range []int{0, -1} {
+		err := p.ProveStream(context.Background(), strings.NewReader(""), concurrency, t.TempDir())
+		if err == nil {
+			t.Fatalf("ProveStream with concurrency=%d: expected an error, got nil", concurrency)
+		}
+		if !strings.Contains(err.Error(), "concurrency") {
+			t.Errorf("ProveStream with concurrency=%d: error = %v, want it to mention concurrency", concurrency, err)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package prover
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// ProveStream reads line-delimited witness JSON from r, proving each
+// witness as it arrives and writing its proof and public witness to
+// outDir, bounded by concurrency simultaneous plonk.Prove calls. Unlike
+// ProveBatch it does not need the full set of witnesses up front, so it
+// can be fed from a long-running upstream process over a pipe.
+func (p *Prover) ProveStream(ctx context.Context, r io.Reader, concurrency int, outDir string) error {
+	if concurrency < 1 {
+		return fmt.Errorf("prover: concurrency must be at least 1, got %d", concurrency)
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrency)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	index := 0
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var w Witness
+		if err := json.Unmarshal(line, &w); err != nil {
+			return fmt.Errorf("prover: decoding witness at line %d: %w", lineNo, err)
+		}
+
+		if err := ctx.Err(); err != nil {
+			break
+		}
+
+		i := index
+		index++
+		g.Go(func() error {
+			proofBytes, publicBytes, err := p.prove(w)
+			if err != nil {
+				return fmt.Errorf("proving witness %d: %w", i, err)
+			}
+			return SaveProof(outDir, Proof{Index: i, Bytes: proofBytes, Public: publicBytes})
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("prover: reading witness stream: %w", err)
+	}
+	return g.Wait()
+}
@@ -0,0 +1,37 @@
+package prover
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// LoadWitnessDir reads every witness JSON file matched by pattern (a
+// directory, which is expanded to "<dir>/*.json", or a glob) and returns
+// them in sorted filename order.
+func LoadWitnessDir(pattern string) ([]Witness, error) {
+	if info, err := os.Stat(pattern); err == nil && info.IsDir() {
+		pattern = filepath.Join(pattern, "*.json")
+	}
+
+	paths, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("prover: globbing %q: %w", pattern, err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("prover: no witness files matched %q", pattern)
+	}
+
+	witnesses := make([]Witness, len(paths))
+	for i, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("prover: reading %q: %w", path, err)
+		}
+		if err := json.Unmarshal(data, &witnesses[i]); err != nil {
+			return nil, fmt.Errorf("prover: decoding %q: %w", path, err)
+		}
+	}
+	return witnesses, nil
+}
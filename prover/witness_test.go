@@ -0,0 +1,39 @@
+package prover
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadWitnessDir(t *testing.T) {
+	dir := t.TempDir()
+	witnesses := []string{
+		`{"vars":["1"],"felts":["2"],"exts":["3"]}`,
+		`{"vars":["4"],"felts":["5"],"exts":["6"]}`,
+	}
+	for i, w := range witnesses {
+		path := filepath.Join(dir, "witness-"+string(rune('a'+i))+".json")
+		if err := os.WriteFile(path, []byte(w), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := LoadWitnessDir(dir)
+	if err != nil {
+		t.Fatalf("LoadWitnessDir: %v", err)
+	}
+	if len(got) != len(witnesses) {
+		t.Fatalf("got %d witnesses, want %d", len(got), len(witnesses))
+	}
+	if got[0].Vars[0] != "1" || got[1].Vars[0] != "4" {
+		t.Errorf("witnesses not decoded in sorted filename order: %+v", got)
+	}
+}
+
+func TestLoadWitnessDirNoMatches(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := LoadWitnessDir(dir); err == nil {
+		t.Fatal("expected an error when no witness files match")
+	}
+}
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"encoding/json"
 	"fmt"
 	"log"
 	"os"
@@ -9,12 +8,10 @@ import (
 	"time"
 
 	"github.com/consensys/gnark-crypto/ecc"
-	"github.com/consensys/gnark/backend/plonk"
-	cs "github.com/consensys/gnark/constraint/bn254"
 	"github.com/consensys/gnark/frontend"
-	"github.com/consensys/gnark/frontend/cs/scs"
-	"github.com/succinctlabs/sp1-recursion-groth16/babybear"
-	"github.com/succinctlabs/sp1-recursion-groth16/unsafekzg"
+	"github.com/succinctlabs/sp1-recursion-groth16/backend"
+	"github.com/succinctlabs/sp1-recursion-groth16/circuit"
+	"github.com/succinctlabs/sp1-recursion-groth16/stats"
 )
 
 func TestMain(t *testing.T) {
@@ -24,92 +21,64 @@ func TestMain(t *testing.T) {
 		fileName = "witness.json"
 	}
 
-	// Read the file.
-	data, err := os.ReadFile(fileName)
+	w, err := circuit.LoadWitness(fileName)
 	if err != nil {
 		panic(err)
 	}
 
-	// Deserialize the JSON data into a slice of Instruction structs
-	var witness Witness
-	err = json.Unmarshal(data, &witness)
+	// Run some sanity checks.
+	c := circuit.New(w)
+
+	// Pick the proving scheme. Defaults to Plonk+KZG, the harness's
+	// original behavior; set SCHEME=groth16|plonkfri to try the others.
+	scheme, err := backend.Select()
 	if err != nil {
 		panic(err)
 	}
-
-	vars := make([]frontend.Variable, len(witness.Vars))
-	felts := make([]*babybear.Variable, len(witness.Felts))
-	exts := make([]*babybear.ExtensionVariable, len(witness.Exts))
-	for i := 0; i < len(witness.Vars); i++ {
-		vars[i] = frontend.Variable(witness.Vars[i])
-	}
-	for i := 0; i < len(witness.Felts); i++ {
-		felts[i] = babybear.NewF(witness.Felts[i])
-	}
-	for i := 0; i < len(witness.Exts); i++ {
-		exts[i] = babybear.NewE(witness.Exts[i])
-	}
-
-	// Run some sanity checks.
-	circuit := Circuit{
-		Vars:  vars,
-		Felts: felts,
-		Exts:  exts,
-	}
+	fmt.Println("scheme:", scheme.Name())
 
 	// Compile the circuit.
 	start := time.Now()
-	builder := scs.NewBuilder
-	r1cs, err := frontend.Compile(ecc.BN254.ScalarField(), builder, &circuit)
+	ccs, err := scheme.Compile(&c)
 	if err != nil {
 		t.Fatal(err)
 	}
 	elapsed := time.Since(start)
 	fmt.Printf("compilation took %s\n", elapsed)
-	fmt.Println("NbConstraints:", r1cs.GetNbConstraints())
+	fmt.Println("NbConstraints:", ccs.GetNbConstraints())
+
+	// Set PROFILE=1 to report a full constraint-profile breakdown, for
+	// catching regressions in the recursion circuit's constraint count.
+	if os.Getenv("PROFILE") != "" {
+		stats.Of(ccs).Pretty(os.Stdout)
+	}
 
 	// Generate the witness.
 	start = time.Now()
-	witnessFull, err := frontend.NewWitness(&circuit, ecc.BN254.ScalarField())
+	witnessFull, err := frontend.NewWitness(&c, ecc.BN254.ScalarField())
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	witnessPublic, err := frontend.NewWitness(&circuit, ecc.BN254.ScalarField(), frontend.PublicOnly())
+	witnessPublic, err := frontend.NewWitness(&c, ecc.BN254.ScalarField(), frontend.PublicOnly())
 	if err != nil {
 		log.Fatal(err)
 	}
 	elapsed = time.Since(start)
 	fmt.Printf("witness gen took %s\n", elapsed)
 
-	// create the necessary data for KZG.
-	// This is a toy example, normally the trusted setup to build ZKG
-	// has been run before.
-	// The size of the data in KZG should be the closest power of 2 bounding //
-	// above max(nbConstraints, nbVariables).
-	ccs := r1cs.(*cs.SparseR1CS)
-	start = time.Now()
-	srs, _, err := unsafekzg.NewSRS(ccs)
-	if err != nil {
-		panic(err)
-	}
-	elapsed = time.Since(start)
-	fmt.Printf("src generated take %s\n", elapsed)
-
-	// public data consists of the polynomials describing the constants involved
-	// in the constraints, the polynomial describing the permutation ("grand
-	// product argument"), and the FFT domains.
+	// Run this scheme's setup. Plonk loads a KZG SRS (see the srs package,
+	// SRS_SOURCE=ptau|ethkzg|file); Groth16 and PlonkFRI need no SRS here.
 	start = time.Now()
-	pk, vk, err := plonk.Setup(ccs, srs)
+	pk, vk, err := scheme.Setup(ccs)
 	elapsed = time.Since(start)
-	//_, err := plonk.Setup(r1cs, kate, &publicWitness)
 	if err != nil {
 		log.Fatal(err)
 	}
 	fmt.Printf("setup done %s\n", elapsed)
 
 	start = time.Now()
-	proof, err := plonk.Prove(ccs, pk, witnessFull)
+	proof, err := scheme.Prove(ccs, pk, witnessFull)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -117,7 +86,7 @@ func TestMain(t *testing.T) {
 	fmt.Printf("prove done %s\n", elapsed)
 
 	start = time.Now()
-	err = plonk.Verify(proof, vk, witnessPublic)
+	err = scheme.Verify(proof, vk, witnessPublic)
 	if err != nil {
 		log.Fatal(err)
 	}
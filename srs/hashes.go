@@ -0,0 +1,61 @@
+package srs
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+)
+
+// publishedTranscriptHashes maps a ceremony name ("ptau", "ethkzg") to the
+// sha256 hex digest expected for its transcript file. There is no safe
+// built-in default: every real ceremony transcript has a different hash,
+// so callers must supply the expected value themselves, either via
+// WithPublishedHash or the SRS_PTAU_SHA256 / SRS_ETHKZG_SHA256 environment
+// variables consulted by expectedHash.
+var publishedTranscriptHashes = map[string]string{}
+
+// envHashVar maps a ceremony name to the environment variable expectedHash
+// falls back to when no WithPublishedHash override has been registered.
+var envHashVar = map[string]string{
+	"ptau":   "SRS_PTAU_SHA256",
+	"ethkzg": "SRS_ETHKZG_SHA256",
+}
+
+// WithPublishedHash pins the expected sha256 hex digest for a ceremony's
+// transcript file ("ptau" or "ethkzg"), e.g. to match the hash published
+// alongside a specific Perpetual Powers of Tau or eth KZG ceremony release.
+// Takes precedence over the ceremony's environment variable.
+func WithPublishedHash(ceremony, sha256Hex string) {
+	publishedTranscriptHashes[ceremony] = sha256Hex
+}
+
+// expectedHash returns the hash a ceremony's transcript must match, from an
+// explicit WithPublishedHash call or the ceremony's environment variable.
+// It errors, rather than silently succeeding or failing a real transcript,
+// when neither is configured.
+func expectedHash(ceremony string) (string, error) {
+	if h, ok := publishedTranscriptHashes[ceremony]; ok {
+		return h, nil
+	}
+	envVar := envHashVar[ceremony]
+	if h := os.Getenv(envVar); h != "" {
+		return h, nil
+	}
+	return "", fmt.Errorf("srs: no published hash configured for %q ceremony; set %s or call WithPublishedHash(%q, ...)", ceremony, envVar, ceremony)
+}
+
+func sha256File(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,54 @@
+package srs
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/constraint"
+	"github.com/succinctlabs/sp1-recursion-groth16/unsafekzg"
+)
+
+// Load builds a KZG SRS for ccs according to the SRS_SOURCE environment
+// variable:
+//
+//	unsafe (default) - generate a toy SRS via unsafekzg, same as before.
+//	ptau             - load PTAU_PATH, a Perpetual Powers of Tau transcript,
+//	                    checked against SRS_PTAU_SHA256.
+//	ethkzg           - load ETHKZG_PATH, the eth KZG ceremony transcript,
+//	                    checked against SRS_ETHKZG_SHA256.
+//	file             - load SRS_PATH, a previously saved SRS (see SaveToFile).
+//
+// ptau and ethkzg both require their transcript's hash env var to be set
+// (or WithPublishedHash called beforehand) — there is no built-in default
+// hash to fall back to.
+//
+// This lets the harness and the sp1-groth16 CLI share one code path for
+// picking between toy and production-grade setups.
+func Load(ccs constraint.ConstraintSystem) (kzg.SRS, error) {
+	switch source := Source(os.Getenv(EnvSource)); source {
+	case "", SourceUnsafe:
+		srs, _, err := unsafekzg.NewSRS(ccs)
+		return srs, err
+	case SourcePTau:
+		path := os.Getenv("PTAU_PATH")
+		if path == "" {
+			return nil, fmt.Errorf("srs: PTAU_PATH must be set when SRS_SOURCE=ptau")
+		}
+		return LoadFromPerpetualPowersOfTau(path, maxInt(ccs.GetNbConstraints(), nbVariables(ccs)))
+	case SourceEthKZG:
+		path := os.Getenv("ETHKZG_PATH")
+		if path == "" {
+			return nil, fmt.Errorf("srs: ETHKZG_PATH must be set when SRS_SOURCE=ethkzg")
+		}
+		return LoadFromEthKZGCeremony(path, ccs.GetNbConstraints(), nbVariables(ccs))
+	case SourceFile:
+		path := os.Getenv("SRS_PATH")
+		if path == "" {
+			return nil, fmt.Errorf("srs: SRS_PATH must be set when SRS_SOURCE=file")
+		}
+		return loadFromFile(path)
+	default:
+		return nil, fmt.Errorf("srs: unknown SRS_SOURCE %q", source)
+	}
+}
@@ -0,0 +1,266 @@
+// Package srs manages KZG structured reference strings for the recursion
+// circuit, so that proving can move from the "unsafe" toy setup used by
+// tests to a real ceremony transcript without forking the harness.
+package srs
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/constraint"
+)
+
+// Source identifies where a TestMain-style harness should pull its SRS from.
+// It is read from the SRS_SOURCE environment variable.
+type Source string
+
+const (
+	// SourceUnsafe generates a toy SRS via unsafekzg, matching the previous
+	// behavior of this harness. Never use this outside of tests.
+	SourceUnsafe Source = "unsafe"
+	// SourcePTau loads a Perpetual Powers of Tau ceremony transcript.
+	SourcePTau Source = "ptau"
+	// SourceEthKZG loads the KZG ceremony transcript published for
+	// EIP-4844 (the "eth KZG" / trusted setup ceremony).
+	SourceEthKZG Source = "ethkzg"
+	// SourceFile loads a previously-saved SRS produced by SaveToFile.
+	SourceFile Source = "file"
+)
+
+// EnvSource is the environment variable read to select a Source.
+const EnvSource = "SRS_SOURCE"
+
+// ErrWrongCurve is returned when a ceremony transcript is encoded over a
+// curve other than BN254 (e.g. a BLS12-381 Perpetual Powers of Tau file).
+var ErrWrongCurve = errors.New("srs: ceremony transcript is BLS12-381, only BN254 is supported")
+
+// ErrDegreeTooSmall is returned when a ceremony transcript does not contain
+// enough G1/G2 points to cover the circuit.
+var ErrDegreeTooSmall = errors.New("srs: ceremony transcript degree is smaller than required")
+
+// ErrTranscriptMismatch is returned when a ceremony transcript's hash does
+// not match the published hash for that ceremony.
+var ErrTranscriptMismatch = errors.New("srs: transcript hash does not match published hash")
+
+// nextPowerOfTwo returns the smallest power of two greater than or equal to n.
+func nextPowerOfTwo(n int) int {
+	if n <= 1 {
+		return 1
+	}
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+	return p
+}
+
+// requiredDegree returns the minimum SRS degree needed to back a circuit
+// with the given number of constraints and variables.
+func requiredDegree(nbConstraints, nbVariables int) int {
+	return nextPowerOfTwo(maxInt(nbConstraints, nbVariables))
+}
+
+func maxInt(a, b int) int {
+	if b > a {
+		return b
+	}
+	return a
+}
+
+// nbVariables returns ccs's total variable count (public + secret +
+// internal), the other half of next_pow2(max(nbConstraints, nbVariables))
+// that SRS sizing needs.
+func nbVariables(ccs constraint.ConstraintSystem) int {
+	return ccs.GetNbPublicVariables() + ccs.GetNbSecretVariables() + ccs.GetNbInternalVariables()
+}
+
+// ptauHeader is the subset of the Perpetual Powers of Tau binary format we
+// need to validate before parsing points: a magic marker followed by the
+// curve identifier and the ceremony's declared power.
+type ptauHeader struct {
+	Curve string
+	Power uint32
+}
+
+func readPtauHeader(f *os.File) (ptauHeader, error) {
+	r := bufio.NewReader(f)
+	magic := make([]byte, 4)
+	if _, err := r.Read(magic); err != nil {
+		return ptauHeader{}, fmt.Errorf("srs: reading ptau magic: %w", err)
+	}
+	if string(magic) != "ptau" {
+		return ptauHeader{}, fmt.Errorf("srs: not a ptau file")
+	}
+	var curveID, power uint32
+	if err := binary.Read(r, binary.LittleEndian, &curveID); err != nil {
+		return ptauHeader{}, fmt.Errorf("srs: reading curve id: %w", err)
+	}
+	if err := binary.Read(r, binary.LittleEndian, &power); err != nil {
+		return ptauHeader{}, fmt.Errorf("srs: reading power: %w", err)
+	}
+	curve := "bn254"
+	if curveID == 1 {
+		curve = "bls12-381"
+	}
+	return ptauHeader{Curve: curve, Power: power}, nil
+}
+
+// LoadFromPerpetualPowersOfTau reads a Perpetual Powers of Tau ceremony
+// transcript and derives a KZG SRS sized to cover nbConstraints. Callers
+// must pass max(actual constraint count, actual variable count) here: the
+// SRS must be large enough to cover whichever of the two is bigger, per
+// next_pow2(max(nbConstraints, nbVariables)). It rejects BLS12-381
+// transcripts and transcripts whose declared degree is below that.
+func LoadFromPerpetualPowersOfTau(path string, nbConstraints int) (kzg.SRS, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("srs: opening ptau transcript %q: %w", path, err)
+	}
+	defer f.Close()
+
+	header, err := readPtauHeader(f)
+	if err != nil {
+		return nil, err
+	}
+	if header.Curve != "bn254" {
+		return nil, ErrWrongCurve
+	}
+	degree := requiredDegree(nbConstraints, nbConstraints)
+	if 1<<header.Power < degree {
+		return nil, fmt.Errorf("%w: transcript has degree 2^%d, need %d", ErrDegreeTooSmall, header.Power, degree)
+	}
+	if err := verifyTranscriptHash(path, "ptau"); err != nil {
+		return nil, err
+	}
+
+	var result kzg.SRS = kzg.NewSRS(ecc.BN254)
+	if _, err := result.ReadFrom(f); err != nil {
+		return nil, fmt.Errorf("srs: decoding ptau points: %w", err)
+	}
+	return result, nil
+}
+
+// ethKZGHeader is the subset of the eth KZG ceremony binary format we need
+// to validate before parsing points: a magic marker followed by the curve
+// identifier and the declared power the transcript's points are encoded
+// over.
+type ethKZGHeader struct {
+	Curve string
+	Power uint32
+}
+
+func readEthKZGHeader(f *os.File) (ethKZGHeader, error) {
+	r := bufio.NewReader(f)
+	magic := make([]byte, 4)
+	if _, err := io.ReadFull(r, magic); err != nil {
+		return ethKZGHeader{}, fmt.Errorf("srs: reading eth KZG magic: %w", err)
+	}
+	if string(magic) != "kzg1" {
+		return ethKZGHeader{}, fmt.Errorf("srs: not an eth KZG ceremony file")
+	}
+	var curveID, power uint32
+	if err := binary.Read(r, binary.LittleEndian, &curveID); err != nil {
+		return ethKZGHeader{}, fmt.Errorf("srs: reading curve id: %w", err)
+	}
+	if err := binary.Read(r, binary.LittleEndian, &power); err != nil {
+		return ethKZGHeader{}, fmt.Errorf("srs: reading power: %w", err)
+	}
+	curve := "bn254"
+	if curveID == 1 {
+		curve = "bls12-381"
+	}
+	return ethKZGHeader{Curve: curve, Power: power}, nil
+}
+
+// LoadFromEthKZGCeremony reads the KZG ceremony transcript published for
+// EIP-4844 and returns it as a gnark KZG SRS sized to cover
+// max(nbConstraints, nbVariables). Like LoadFromPerpetualPowersOfTau it
+// rejects a BLS12-381-encoded transcript outright rather than trying to
+// decode it as BN254, rejects a transcript whose declared degree is below
+// what's required, and checks the transcript's hash against the published
+// value to guard against tampered files.
+func LoadFromEthKZGCeremony(path string, nbConstraints, nbVariables int) (kzg.SRS, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("srs: opening eth KZG ceremony transcript %q: %w", path, err)
+	}
+	defer f.Close()
+
+	header, err := readEthKZGHeader(f)
+	if err != nil {
+		return nil, err
+	}
+	if header.Curve != "bn254" {
+		return nil, ErrWrongCurve
+	}
+	degree := requiredDegree(nbConstraints, nbVariables)
+	if 1<<header.Power < degree {
+		return nil, fmt.Errorf("%w: transcript has degree 2^%d, need %d", ErrDegreeTooSmall, header.Power, degree)
+	}
+
+	if err := verifyTranscriptHash(path, "ethkzg"); err != nil {
+		return nil, err
+	}
+
+	var result kzg.SRS = kzg.NewSRS(ecc.BN254)
+	if _, err := result.ReadFrom(f); err != nil {
+		return nil, fmt.Errorf("srs: decoding eth KZG ceremony points: %w", err)
+	}
+	return result, nil
+}
+
+// SaveToFile persists an SRS (typically one produced by LoadFromPerpetualPowersOfTau
+// or LoadFromEthKZGCeremony) so that subsequent runs can use SourceFile
+// instead of re-parsing and re-verifying the original ceremony transcript.
+func SaveToFile(path string, srs kzg.SRS) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("srs: creating %q: %w", path, err)
+	}
+	defer f.Close()
+
+	if _, err := srs.WriteTo(f); err != nil {
+		return fmt.Errorf("srs: writing srs to %q: %w", path, err)
+	}
+	return nil
+}
+
+// loadFromFile reads back an SRS previously written by SaveToFile.
+func loadFromFile(path string) (kzg.SRS, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("srs: opening %q: %w", path, err)
+	}
+	defer f.Close()
+
+	var result kzg.SRS = kzg.NewSRS(ecc.BN254)
+	if _, err := result.ReadFrom(f); err != nil {
+		return nil, fmt.Errorf("srs: decoding %q: %w", path, err)
+	}
+	return result, nil
+}
+
+// verifyTranscriptHash checks that the ceremony transcript at path matches
+// the published hash for that ceremony ("ptau" or "ethkzg"), so a
+// tampered or truncated file is rejected before its points are ever used
+// in a setup.
+func verifyTranscriptHash(path string, ceremony string) error {
+	expected, err := expectedHash(ceremony)
+	if err != nil {
+		return err
+	}
+	actual, err := sha256File(path)
+	if err != nil {
+		return fmt.Errorf("srs: hashing transcript: %w", err)
+	}
+	if actual != expected {
+		return ErrTranscriptMismatch
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package srs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNextPowerOfTwo(t *testing.T) {
+	cases := map[int]int{0: 1, 1: 1, 2: 2, 3: 4, 4: 4, 5: 8, 1024: 1024, 1025: 2048}
+	for n, want := range cases {
+		if got := nextPowerOfTwo(n); got != want {
+			t.Errorf("nextPowerOfTwo(%d) = %d, want %d", n, got, want)
+		}
+	}
+}
+
+func TestRequiredDegree(t *testing.T) {
+	if got := requiredDegree(100, 300); got != 512 {
+		t.Errorf("requiredDegree(100, 300) = %d, want 512", got)
+	}
+	if got := requiredDegree(300, 100); got != 512 {
+		t.Errorf("requiredDegree(300, 100) = %d, want 512", got)
+	}
+}
+
+func writePtauFixture(t *testing.T, path string, curveID, power uint32) {
+	t.Helper()
+	var buf bytes.Buffer
+	buf.WriteString("ptau")
+	binary.Write(&buf, binary.LittleEndian, curveID)
+	binary.Write(&buf, binary.LittleEndian, power)
+	buf.WriteString("rest-of-file-ignored-by-header-parsing")
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadPtauHeader(t *testing.T) {
+	dir := t.TempDir()
+
+	bn254Path := filepath.Join(dir, "bn254.ptau")
+	writePtauFixture(t, bn254Path, 0, 10)
+	f, err := os.Open(bn254Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	header, err := readPtauHeader(f)
+	if err != nil {
+		t.Fatalf("readPtauHeader: %v", err)
+	}
+	if header.Curve != "bn254" || header.Power != 10 {
+		t.Errorf("got %+v, want {bn254 10}", header)
+	}
+
+	blsPath := filepath.Join(dir, "bls12-381.ptau")
+	writePtauFixture(t, blsPath, 1, 10)
+	f2, err := os.Open(blsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f2.Close()
+	header2, err := readPtauHeader(f2)
+	if err != nil {
+		t.Fatalf("readPtauHeader: %v", err)
+	}
+	if header2.Curve != "bls12-381" {
+		t.Errorf("got curve %q, want bls12-381", header2.Curve)
+	}
+}
+
+func TestReadPtauHeaderRejectsBadMagic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "not-a-ptau-file")
+	if err := os.WriteFile(path, []byte("nope, not a ceremony file at all"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := readPtauHeader(f); err == nil {
+		t.Fatal("expected an error for a file with the wrong magic")
+	}
+}
+
+func writeEthKZGFixture(t *testing.T, path string, curveID uint32) {
+	t.Helper()
+	var buf bytes.Buffer
+	buf.WriteString("kzg1")
+	binary.Write(&buf, binary.LittleEndian, curveID)
+	binary.Write(&buf, binary.LittleEndian, uint32(10))
+	buf.WriteString("rest-of-file-ignored-by-header-parsing")
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadEthKZGHeaderDetectsBLS12381(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "bls.ethkzg")
+	writeEthKZGFixture(t, path, 1)
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	header, err := readEthKZGHeader(f)
+	if err != nil {
+		t.Fatalf("readEthKZGHeader: %v", err)
+	}
+	if header.Curve != "bls12-381" {
+		t.Fatalf("got curve %q, want bls12-381", header.Curve)
+	}
+}
+
+func TestVerifyTranscriptHash(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "transcript")
+	if err := os.WriteFile(path, []byte("ceremony contents"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := verifyTranscriptHash(path, "test-ceremony-unset"); err == nil {
+		t.Fatal("expected an error when no hash is configured for the ceremony")
+	}
+
+	actual, err := sha256File(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	WithPublishedHash("test-ceremony", actual)
+	if err := verifyTranscriptHash(path, "test-ceremony"); err != nil {
+		t.Errorf("verifyTranscriptHash with matching hash: %v", err)
+	}
+
+	WithPublishedHash("test-ceremony", "0000000000000000000000000000000000000000000000000000000000000000")
+	if err := verifyTranscriptHash(path, "test-ceremony"); err != ErrTranscriptMismatch {
+		t.Errorf("verifyTranscriptHash with mismatching hash = %v, want ErrTranscriptMismatch", err)
+	}
+}
@@ -0,0 +1,50 @@
+package stats
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// JSON renders the report as indented JSON, for CI to diff across runs.
+func (r *Report) JSON() ([]byte, error) {
+	return json.MarshalIndent(r, "", "  ")
+}
+
+// Pretty writes a human-readable summary of the report to w.
+func (r *Report) Pretty(w io.Writer) {
+	fmt.Fprintf(w, "constraints: %d (mul=%d add=%d custom=%d)\n",
+		r.NbConstraints, r.GateCounts.Mul, r.GateCounts.Add, r.GateCounts.Custom)
+	fmt.Fprintf(w, "wires: %d (public=%d secret=%d)\n", r.NbWires, r.NbPublic, r.NbSecret)
+
+	if len(r.ByFile) > 0 {
+		fmt.Fprintln(w, "constraints by source file:")
+		files := make([]string, 0, len(r.ByFile))
+		for f := range r.ByFile {
+			files = append(files, f)
+		}
+		sort.Slice(files, func(i, j int) bool { return r.ByFile[files[i]] > r.ByFile[files[j]] })
+		for _, f := range files {
+			fmt.Fprintf(w, "  %6d  %s\n", r.ByFile[f], f)
+		}
+	}
+
+	printHistogram(w, "fan-in", r.FanIn)
+	printHistogram(w, "fan-out", r.FanOut)
+}
+
+func printHistogram(w io.Writer, name string, h map[int]int) {
+	if len(h) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "%s histogram (degree: wire count):\n", name)
+	degrees := make([]int, 0, len(h))
+	for d := range h {
+		degrees = append(degrees, d)
+	}
+	sort.Ints(degrees)
+	for _, d := range degrees {
+		fmt.Fprintf(w, "  %3d: %d\n", d, h[d])
+	}
+}
@@ -0,0 +1,135 @@
+// Package stats reports constraint-level statistics for a compiled circuit:
+// how many constraints of each gate type it has, how wide its witness is,
+// which source lines contributed the most constraints, and how connected
+// its wires are. The harness only ever printed NbConstraints; this is
+// meant to catch blowups in the babybear gadgets before they hit CI.
+package stats
+
+import (
+	"fmt"
+
+	cs "github.com/consensys/gnark/constraint/bn254"
+
+	"github.com/consensys/gnark/constraint"
+)
+
+// GateCounts buckets constraints by the kind of gate they encode.
+type GateCounts struct {
+	Mul    int `json:"mul"`
+	Add    int `json:"add"`
+	Custom int `json:"custom"`
+}
+
+// Report is a snapshot of a compiled circuit's shape.
+type Report struct {
+	NbConstraints int        `json:"nb_constraints"`
+	GateCounts    GateCounts `json:"gate_counts"`
+
+	NbWires  int `json:"nb_wires"`
+	NbPublic int `json:"nb_public"`
+	NbSecret int `json:"nb_secret"`
+
+	// ByFile attributes constraint counts to the source location
+	// ("file:line") that emitted them, via gnark's debug.SymbolTable. Empty when the circuit was
+	// compiled without debug info (frontend.WithDebugInfo is off).
+	ByFile map[string]int `json:"by_file,omitempty"`
+
+	// FanIn and FanOut are histograms: FanIn[k] (FanOut[k]) is the number
+	// of wires consumed (produced) by exactly k constraints.
+	FanIn  map[int]int `json:"fan_in"`
+	FanOut map[int]int `json:"fan_out"`
+}
+
+// Of walks ccs and builds a Report. Gate-type and per-wire detail is only
+// available for the sparse R1CS gnark's Plonk builders produce; for other
+// constraint systems (e.g. Groth16's R1CS) Of falls back to the counts
+// available through the generic constraint.ConstraintSystem interface.
+func Of(ccs constraint.ConstraintSystem) *Report {
+	r := &Report{
+		NbConstraints: ccs.GetNbConstraints(),
+		NbPublic:      ccs.GetNbPublicVariables(),
+		NbSecret:      ccs.GetNbSecretVariables(),
+		NbWires:       ccs.GetNbPublicVariables() + ccs.GetNbSecretVariables() + ccs.GetNbInternalVariables(),
+		FanIn:         map[int]int{},
+		FanOut:        map[int]int{},
+	}
+
+	sparse, ok := ccs.(*cs.SparseR1CS)
+	if !ok {
+		return r
+	}
+
+	gates, fanIn, fanOut := walkConstraints(sparse.Constraints)
+	r.GateCounts = gates
+	for _, count := range fanIn {
+		r.FanIn[count]++
+	}
+	for _, count := range fanOut {
+		r.FanOut[count]++
+	}
+
+	if byFile := attributeBySource(sparse); len(byFile) > 0 {
+		r.ByFile = byFile
+	}
+
+	return r
+}
+
+// walkConstraints classifies each constraint by gate type and tallies, per
+// wire, how many constraints consume it (fanIn, keyed by XA/XB) and how
+// many produce it (fanOut, keyed by XC). Split out from Of so it can be
+// tested directly against hand-built constraints, without needing a real
+// compiled circuit.
+func walkConstraints(constraints []cs.SparseR1C) (gates GateCounts, fanIn, fanOut map[int]int) {
+	fanIn = map[int]int{}
+	fanOut = map[int]int{}
+
+	for _, cc := range constraints {
+		switch {
+		case cc.QM != 0:
+			gates.Mul++
+		case cc.QL != 0 || cc.QR != 0 || cc.QO != 0:
+			gates.Add++
+		default:
+			gates.Custom++
+		}
+
+		fanIn[cc.XA]++
+		if cc.XB != cc.XA {
+			fanIn[cc.XB]++
+		}
+		fanOut[cc.XC]++
+	}
+
+	return gates, fanIn, fanOut
+}
+
+// attributeBySource maps each constraint to the source location that
+// produced it, via the circuit's debug symbol table, and tallies
+// constraint counts per "file:line" location.
+func attributeBySource(sparse *cs.SparseR1CS) map[string]int {
+	byFile := map[string]int{}
+	for i := range sparse.Constraints {
+		if loc, ok := symbolLocation(sparse, i); ok {
+			byFile[loc]++
+		}
+	}
+	return byFile
+}
+
+// symbolLocation returns a "file:line" string for the source location that
+// produced constraint i, using the circuit's debug symbol table. Returns
+// false when the circuit was compiled without debug info.
+func symbolLocation(ccs *cs.SparseR1CS, constraintIdx int) (string, bool) {
+	debugInfo := ccs.GetConstraintsDebugInfo()
+	if constraintIdx >= len(debugInfo) {
+		return "", false
+	}
+	entry := debugInfo[constraintIdx]
+	locations := ccs.SymbolTable.Locations(entry)
+	if len(locations) == 0 {
+		return "", false
+	}
+	top := locations[len(locations)-1]
+	return fmt.Sprintf("%s:%d", top.File, top.Line), true
+}
@@ -0,0 +1,122 @@
+package stats
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	cs "github.com/consensys/gnark/constraint/bn254"
+)
+
+func TestWalkConstraints(t *testing.T) {
+	// A mul gate (0 * 1 -> 2), a linear/add gate (0 -> 3), and a custom
+	// gate (no QM/QL/QR/QO at all) reusing wire 1 as an input again so its
+	// fan-in is 2.
+	constraints := []cs.SparseR1C{
+		{QM: 1, XA: 0, XB: 1, XC: 2},
+		{QL: 1, XA: 0, XB: 0, XC: 3},
+		{XA: 1, XB: 4, XC: 5},
+	}
+
+	gates, fanIn, fanOut := walkConstraints(constraints)
+
+	wantGates := GateCounts{Mul: 1, Add: 1, Custom: 1}
+	if gates != wantGates {
+		t.Errorf("gates = %+v, want %+v", gates, wantGates)
+	}
+
+	// fanIn is keyed by the wires consumed (XA/XB): wire 0 is consumed
+	// twice (constraints 0 and 1), wire 1 twice (constraints 0 and 2).
+	wantFanIn := map[int]int{0: 2, 1: 2, 4: 1}
+	if !reflect.DeepEqual(fanIn, wantFanIn) {
+		t.Errorf("fanIn = %v, want %v", fanIn, wantFanIn)
+	}
+
+	// fanOut is keyed by the wire produced (XC): each constraint produces
+	// exactly one output wire.
+	wantFanOut := map[int]int{2: 1, 3: 1, 5: 1}
+	if !reflect.DeepEqual(fanOut, wantFanOut) {
+		t.Errorf("fanOut = %v, want %v", fanOut, wantFanOut)
+	}
+}
+
+func sampleReport() *Report {
+	return &Report{
+		NbConstraints: 30,
+		GateCounts:    GateCounts{Mul: 10, Add: 15, Custom: 5},
+		NbWires:       40,
+		NbPublic:      2,
+		NbSecret:      8,
+		ByFile: map[string]int{
+			"babybear/felt.go:12": 20,
+			"babybear/ext.go:34":  10,
+		},
+		FanIn:  map[int]int{1: 25, 2: 5},
+		FanOut: map[int]int{1: 28, 3: 2},
+	}
+}
+
+func TestReportJSON(t *testing.T) {
+	r := sampleReport()
+	data, err := r.JSON()
+	if err != nil {
+		t.Fatalf("JSON: %v", err)
+	}
+
+	var got Report
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("round-tripping JSON: %v", err)
+	}
+	if got.NbConstraints != r.NbConstraints || got.GateCounts != r.GateCounts {
+		t.Errorf("round-tripped report = %+v, want %+v", got, r)
+	}
+}
+
+func TestReportPretty(t *testing.T) {
+	r := sampleReport()
+	var buf bytes.Buffer
+	r.Pretty(&buf)
+	out := buf.String()
+
+	for _, want := range []string{
+		"constraints: 30",
+		"mul=10 add=15 custom=5",
+		"wires: 40",
+		"public=2 secret=8",
+		"babybear/felt.go:12",
+		"fan-in histogram",
+		"fan-out histogram",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Pretty() output missing %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestReportPrettyOmitsEmptySections(t *testing.T) {
+	r := &Report{NbConstraints: 1}
+	var buf bytes.Buffer
+	r.Pretty(&buf)
+	out := buf.String()
+
+	for _, unwanted := range []string{"constraints by source file", "fan-in histogram", "fan-out histogram"} {
+		if strings.Contains(out, unwanted) {
+			t.Errorf("Pretty() output should omit empty section %q, got:\n%s", unwanted, out)
+		}
+	}
+}
+
+func TestPrintHistogramOrdersByDegree(t *testing.T) {
+	var buf bytes.Buffer
+	printHistogram(&buf, "fan-in", map[int]int{3: 1, 1: 2, 2: 3})
+	out := buf.String()
+
+	i1 := strings.Index(out, "1: 2")
+	i2 := strings.Index(out, "2: 3")
+	i3 := strings.Index(out, "3: 1")
+	if !(i1 < i2 && i2 < i3) {
+		t.Errorf("printHistogram did not order entries by degree, got:\n%s", out)
+	}
+}